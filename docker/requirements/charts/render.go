@@ -0,0 +1,247 @@
+// Package charts renders a preload.Result as a self-contained, interactive
+// HTML report using go-echarts: a line/scatter view of the raw series, a
+// histogram and boxplot of their distribution, and a stat summary bar chart
+// of the same quantiles exported to XLSX.
+package charts
+
+import (
+	"bytes"
+	"fmt"
+
+	goecharts "github.com/go-echarts/go-echarts/v2/charts"
+	"github.com/go-echarts/go-echarts/v2/components"
+	"github.com/go-echarts/go-echarts/v2/opts"
+	"github.com/montanaflynn/stats"
+
+	"preload/internal/preload"
+)
+
+// Options controls the generated report.
+type Options struct {
+	// Title is shown as the page and chart titles. Defaults to the job name.
+	Title string
+	// HistogramBins is the number of buckets used for the histogram chart.
+	// Defaults to 10.
+	HistogramBins int
+}
+
+// Render builds a self-contained HTML dashboard for result and returns the
+// rendered document.
+func Render(result preload.Result, opt Options) ([]byte, error) {
+	title := opt.Title
+	if title == "" {
+		title = result.Job.Name
+	}
+	bins := opt.HistogramBins
+	if bins <= 0 {
+		bins = 10
+	}
+
+	box, err := boxPlot(title, result.Job.Series)
+	if err != nil {
+		return nil, err
+	}
+
+	page := components.NewPage()
+	page.SetPageTitle(title)
+	page.AddCharts(
+		lineChart(title, result.Job.Series),
+		scatterChart(title, result.Job.Series),
+		barChart(title, result.Job.Series, bins),
+		box,
+		summaryTable(title, result),
+	)
+
+	var buf bytes.Buffer
+	if err := page.Render(&buf); err != nil {
+		return nil, fmt.Errorf("render chart page: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func lineChart(title string, series []preload.Series) *goecharts.Line {
+	c := goecharts.NewLine()
+	c.SetGlobalOptions(
+		goecharts.WithTitleOpts(opts.Title{Title: title + " - series"}),
+	)
+	for _, s := range series {
+		c.SetXAxis(labelsOrIndices(s)).AddSeries(s.Name, lineData(s.Values))
+	}
+	return c
+}
+
+func scatterChart(title string, series []preload.Series) *goecharts.Scatter {
+	c := goecharts.NewScatter()
+	c.SetGlobalOptions(
+		goecharts.WithTitleOpts(opts.Title{Title: title + " - scatter"}),
+	)
+	for _, s := range series {
+		c.SetXAxis(labelsOrIndices(s)).AddSeries(s.Name, scatterData(s.Values))
+	}
+	return c
+}
+
+func barChart(title string, series []preload.Series, bins int) *goecharts.Bar {
+	c := goecharts.NewBar()
+	c.SetGlobalOptions(
+		goecharts.WithTitleOpts(opts.Title{Title: title + " - histogram"}),
+	)
+	for _, s := range series {
+		edges, counts := histogram(s.Values, bins)
+		c.SetXAxis(edges).AddSeries(s.Name, barData(counts))
+	}
+	return c
+}
+
+func boxPlot(title string, series []preload.Series) (*goecharts.BoxPlot, error) {
+	c := goecharts.NewBoxPlot()
+	c.SetGlobalOptions(
+		goecharts.WithTitleOpts(opts.Title{Title: title + " - boxplot"}),
+	)
+	names := make([]string, 0, len(series))
+	for _, s := range series {
+		names = append(names, s.Name)
+	}
+	c.SetXAxis(names)
+	for _, s := range series {
+		summary, err := fiveNumberSummary(s.Values)
+		if err != nil {
+			return nil, fmt.Errorf("boxplot series %q: %w", s.Name, err)
+		}
+		c.AddSeries(s.Name, []opts.BoxPlotData{{Value: summary}})
+	}
+	return c, nil
+}
+
+// summaryTable renders the montanaflynn/stats-derived quantiles as a bar
+// chart of p25/p50/p90 per series, giving a single-glance "stat
+// summary" view alongside the raw-data charts above.
+func summaryTable(title string, result preload.Result) *goecharts.Bar {
+	c := goecharts.NewBar()
+	c.SetGlobalOptions(
+		goecharts.WithTitleOpts(opts.Title{Title: title + " - stat summary"}),
+	)
+	names := make([]string, 0, len(result.Job.Series))
+	for _, s := range result.Job.Series {
+		names = append(names, s.Name)
+	}
+	c.SetXAxis(names)
+
+	series := []string{"p25", "p50", "p90"}
+	for _, q := range series {
+		data := make([]opts.BarData, 0, len(result.Job.Series))
+		for _, s := range result.Job.Series {
+			data = append(data, opts.BarData{Value: result.Summary[s.Name].Quantiles[q]})
+		}
+		c.AddSeries(q, data)
+	}
+	return c
+}
+
+func labelsOrIndices(s preload.Series) []string {
+	if len(s.Labels) == len(s.Values) {
+		return s.Labels
+	}
+	indices := make([]string, len(s.Values))
+	for i := range s.Values {
+		indices[i] = fmt.Sprintf("%d", i)
+	}
+	return indices
+}
+
+func lineData(values []float64) []opts.LineData {
+	data := make([]opts.LineData, len(values))
+	for i, v := range values {
+		data[i] = opts.LineData{Value: v}
+	}
+	return data
+}
+
+func scatterData(values []float64) []opts.ScatterData {
+	data := make([]opts.ScatterData, len(values))
+	for i, v := range values {
+		data[i] = opts.ScatterData{Value: v}
+	}
+	return data
+}
+
+func barData(counts []int) []opts.BarData {
+	data := make([]opts.BarData, len(counts))
+	for i, v := range counts {
+		data[i] = opts.BarData{Value: v}
+	}
+	return data
+}
+
+// histogram buckets values into bins equal-width buckets and returns the
+// bucket edge labels and per-bucket counts.
+func histogram(values []float64, bins int) ([]string, []int) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	min, max := values[0], values[0]
+	for _, v := range values {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	width := (max - min) / float64(bins)
+	if width == 0 {
+		width = 1
+	}
+
+	counts := make([]int, bins)
+	for _, v := range values {
+		idx := int((v - min) / width)
+		if idx >= bins {
+			idx = bins - 1
+		}
+		if idx < 0 {
+			idx = 0
+		}
+		counts[idx]++
+	}
+
+	edges := make([]string, bins)
+	for i := range edges {
+		edges[i] = fmt.Sprintf("%.2f", min+float64(i)*width)
+	}
+	return edges, counts
+}
+
+// fiveNumberSummary computes the [min, Q1, median, Q3, max] values a
+// go-echarts boxplot series expects, via the same montanaflynn/stats
+// percentile method internal/preload.summarize uses for its quantiles.
+func fiveNumberSummary(values []float64) ([]float64, error) {
+	data := stats.Float64Data(values)
+
+	min, err := data.Min()
+	if err != nil {
+		return nil, err
+	}
+	// PercentileNearestRank, not Percentile: Percentile's interpolation
+	// returns BoundsErr for any 2- or 3-value series (see
+	// internal/preload.summarize), which would otherwise fail the boxplot
+	// for perfectly valid small jobs.
+	q1, err := data.PercentileNearestRank(25)
+	if err != nil {
+		return nil, err
+	}
+	median, err := data.Median()
+	if err != nil {
+		return nil, err
+	}
+	q3, err := data.PercentileNearestRank(75)
+	if err != nil {
+		return nil, err
+	}
+	max, err := data.Max()
+	if err != nil {
+		return nil, err
+	}
+
+	return []float64{min, q1, median, q3, max}, nil
+}
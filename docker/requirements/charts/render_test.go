@@ -0,0 +1,93 @@
+package charts
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHistogram(t *testing.T) {
+	cases := []struct {
+		name       string
+		values     []float64
+		bins       int
+		wantCounts []int
+	}{
+		{
+			name:       "even spread",
+			values:     []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			bins:       5,
+			wantCounts: []int{2, 2, 2, 2, 2},
+		},
+		{
+			name:       "single value bucket has zero width",
+			values:     []float64{3, 3, 3},
+			bins:       4,
+			wantCounts: []int{3, 0, 0, 0},
+		},
+		{
+			name:       "empty input",
+			values:     nil,
+			bins:       4,
+			wantCounts: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			edges, counts := histogram(tc.values, tc.bins)
+			if !reflect.DeepEqual(counts, tc.wantCounts) {
+				t.Fatalf("counts = %v, want %v", counts, tc.wantCounts)
+			}
+			if tc.values != nil && len(edges) != tc.bins {
+				t.Fatalf("len(edges) = %d, want %d", len(edges), tc.bins)
+			}
+		})
+	}
+}
+
+func TestFiveNumberSummary(t *testing.T) {
+	summary, err := fiveNumberSummary([]float64{1, 2, 3, 4, 5, 100})
+	if err != nil {
+		t.Fatalf("fiveNumberSummary returned error: %v", err)
+	}
+	if len(summary) != 5 {
+		t.Fatalf("len(summary) = %d, want 5", len(summary))
+	}
+	min, max := summary[0], summary[4]
+	if min != 1 {
+		t.Errorf("min = %v, want 1", min)
+	}
+	if max != 100 {
+		t.Errorf("max = %v, want 100", max)
+	}
+	q1, median, q3 := summary[1], summary[2], summary[3]
+	if !(min <= q1 && q1 <= median && median <= q3 && q3 <= max) {
+		t.Errorf("summary not ordered: %v", summary)
+	}
+}
+
+func TestFiveNumberSummaryEmptyInput(t *testing.T) {
+	if _, err := fiveNumberSummary(nil); err == nil {
+		t.Fatal("expected an error for an empty series, got nil")
+	}
+}
+
+func TestFiveNumberSummaryHandlesSmallSeries(t *testing.T) {
+	// Regression test: stats.Float64Data.Percentile returns BoundsErr for
+	// any 2- or 3-value series, which would otherwise fail the boxplot for
+	// a perfectly valid small job.
+	cases := []struct {
+		name   string
+		values []float64
+	}{
+		{"two values", []float64{1, 2}},
+		{"three values", []float64{1, 2, 3}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := fiveNumberSummary(tc.values); err != nil {
+				t.Fatalf("fiveNumberSummary(%v) returned error: %v", tc.values, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var verbose bool
+
+var rootCmd = &cobra.Command{
+	Use:   "preload",
+	Short: "Capacity planning and preload job runner",
+	Long: `preload runs capacity/preload sizing calculations over a set of
+input series and exports the results as CSV, XLSX, and/or an interactive
+HTML report.`,
+}
+
+// Execute runs the root command, exiting the process on error.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		logrus.WithError(err).Error("preload failed")
+		os.Exit(1)
+	}
+}
+
+func init() {
+	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "enable debug logging")
+	cobra.OnInitialize(initLogging)
+}
+
+func initLogging() {
+	if verbose {
+		logrus.SetLevel(logrus.DebugLevel)
+	}
+}
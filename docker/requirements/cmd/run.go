@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"preload/charts"
+	"preload/internal/export"
+	"preload/internal/preload"
+	"preload/sysprobe"
+)
+
+var (
+	runInput    string
+	runCSVOut   string
+	runXLSXOut  string
+	runChartOut string
+)
+
+var runCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run a preload job and export its results",
+	Long: `run reads a job description (series of values to analyze) from a
+JSON file and writes the requested outputs: a flat CSV, an XLSX workbook,
+and/or an interactive HTML chart report.`,
+	RunE: runRun,
+}
+
+func init() {
+	runCmd.Flags().StringVar(&runInput, "input", "", "path to a JSON job description (required)")
+	runCmd.Flags().StringVar(&runCSVOut, "csv-out", "", "write a flat CSV of the series to this path")
+	runCmd.Flags().StringVar(&runXLSXOut, "xlsx-out", "", "write an XLSX workbook to this path")
+	runCmd.Flags().StringVar(&runChartOut, "chart-out", "", "write an interactive HTML chart report to this path")
+	_ = runCmd.MarkFlagRequired("input")
+	rootCmd.AddCommand(runCmd)
+}
+
+func runRun(cmd *cobra.Command, args []string) error {
+	raw, err := os.ReadFile(runInput)
+	if err != nil {
+		return fmt.Errorf("read input: %w", err)
+	}
+	var job preload.Job
+	if err := json.Unmarshal(raw, &job); err != nil {
+		return fmt.Errorf("parse input: %w", err)
+	}
+
+	snap, err := sysprobe.Sample()
+	if err != nil {
+		return fmt.Errorf("sample host: %w", err)
+	}
+	plan := preload.PlanFor(preload.TargetFor(job), snap)
+
+	result, err := preload.Run(job, plan)
+	if err != nil {
+		return fmt.Errorf("run job: %w", err)
+	}
+
+	if runCSVOut != "" {
+		if err := export.WriteCSV(result, runCSVOut); err != nil {
+			return fmt.Errorf("write csv: %w", err)
+		}
+	}
+	if runXLSXOut != "" {
+		if err := export.WriteXLSX(result, runXLSXOut); err != nil {
+			return fmt.Errorf("write xlsx: %w", err)
+		}
+	}
+	if runChartOut != "" {
+		html, err := charts.Render(result, charts.Options{Title: job.Name})
+		if err != nil {
+			return fmt.Errorf("render chart: %w", err)
+		}
+		if err := os.WriteFile(runChartOut, html, 0o644); err != nil {
+			return fmt.Errorf("write chart: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"preload/internal/httpapi"
+)
+
+var (
+	serveAddr      string
+	serveOutputDir string
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve the preload HTTP API",
+	Long: `serve starts the HTTP API for submitting preload jobs, polling
+their readiness, and reading the host's sysprobe snapshot.`,
+	RunE: runServe,
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveAddr, "addr", ":8080", "address to listen on")
+	serveCmd.Flags().StringVar(&serveOutputDir, "output-dir", ".", "directory job exports are written to")
+	rootCmd.AddCommand(serveCmd)
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	if err := os.MkdirAll(serveOutputDir, 0o755); err != nil {
+		return fmt.Errorf("create output dir: %w", err)
+	}
+	server := httpapi.NewServer(serveOutputDir)
+	logrus.WithField("addr", serveAddr).Info("starting preload HTTP API")
+	return http.ListenAndServe(serveAddr, server.Handler())
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/tidwall/gjson"
+
+	"preload/sysprobe"
+)
+
+var (
+	statusJSON  bool
+	statusQuery string
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Print the host's current resource snapshot",
+	Long: `status samples the host's CPU, memory, disk IO, and (if present)
+cgroup limits, the same snapshot preload's sizing decisions are based on.`,
+	RunE: runStatus,
+}
+
+func init() {
+	statusCmd.Flags().BoolVar(&statusJSON, "json", false, "print the snapshot as JSON instead of a table")
+	statusCmd.Flags().StringVar(&statusQuery, "query", "", "gjson path to extract from the JSON snapshot (implies --json)")
+	rootCmd.AddCommand(statusCmd)
+}
+
+func runStatus(cmd *cobra.Command, args []string) error {
+	snap, err := sysprobe.Sample()
+	if err != nil {
+		return fmt.Errorf("sample host: %w", err)
+	}
+
+	raw, err := json.Marshal(snap)
+	if err != nil {
+		return fmt.Errorf("marshal snapshot: %w", err)
+	}
+
+	if statusQuery != "" {
+		fmt.Println(gjson.GetBytes(raw, statusQuery).String())
+		return nil
+	}
+	if statusJSON {
+		var pretty []byte
+		pretty, err = json.MarshalIndent(snap, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal snapshot: %w", err)
+		}
+		fmt.Println(string(pretty))
+		return nil
+	}
+
+	printStatusTable(snap)
+	return nil
+}
+
+func printStatusTable(snap sysprobe.Snapshot) {
+	label := color.New(color.FgCyan).SprintFunc()
+	fmt.Printf("%s %d\n", label("cpu count:"), snap.CPUCount)
+	fmt.Printf("%s %.1f%%\n", label("cpu percent:"), snap.CPUPercent)
+	fmt.Printf("%s %d bytes\n", label("mem total:"), snap.MemTotal)
+	fmt.Printf("%s %d bytes\n", label("mem available:"), snap.MemAvail)
+	fmt.Printf("%s %d\n", label("disk read ops:"), snap.DiskReadOps)
+	fmt.Printf("%s %d\n", label("disk write ops:"), snap.DiskWriteOps)
+	if snap.Cgroup == nil {
+		fmt.Printf("%s none detected\n", label("cgroup:"))
+		return
+	}
+	fmt.Printf("%s memory limit %d bytes, cpu quota %.2f\n",
+		label("cgroup:"), snap.Cgroup.MemoryLimitBytes, snap.Cgroup.CPUQuota)
+}
@@ -0,0 +1,42 @@
+// Package export writes a preload.Result out to the file formats
+// downstream tooling expects: flat CSV rows for spreadsheets/BI tools and
+// a multi-sheet XLSX workbook for humans.
+package export
+
+import (
+	"os"
+
+	"github.com/gocarina/gocsv"
+
+	"preload/internal/preload"
+)
+
+// row is the flat, one-value-per-line shape gocsv marshals to CSV.
+type row struct {
+	Series string  `csv:"series"`
+	Index  int     `csv:"index"`
+	Label  string  `csv:"label"`
+	Value  float64 `csv:"value"`
+}
+
+// WriteCSV marshals every series in result to a flat CSV file at path.
+func WriteCSV(result preload.Result, path string) error {
+	rows := make([]*row, 0)
+	for _, s := range result.Job.Series {
+		for i, v := range s.Values {
+			label := ""
+			if i < len(s.Labels) {
+				label = s.Labels[i]
+			}
+			rows = append(rows, &row{Series: s.Name, Index: i, Label: label, Value: v})
+		}
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return gocsv.MarshalFile(&rows, f)
+}
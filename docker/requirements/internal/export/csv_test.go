@@ -0,0 +1,53 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gocarina/gocsv"
+
+	"preload/internal/preload"
+)
+
+func TestWriteCSV(t *testing.T) {
+	result := preload.Result{
+		Job: preload.Job{
+			Name: "demo",
+			Series: []preload.Series{
+				{Name: "a", Labels: []string{"x", "y"}, Values: []float64{1, 2}},
+				{Name: "b", Values: []float64{3}},
+			},
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "out.csv")
+	if err := WriteCSV(result, path); err != nil {
+		t.Fatalf("WriteCSV returned error: %v", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open written CSV: %v", err)
+	}
+	defer f.Close()
+
+	var rows []*row
+	if err := gocsv.UnmarshalFile(f, &rows); err != nil {
+		t.Fatalf("unmarshal written CSV: %v", err)
+	}
+
+	want := []*row{
+		{Series: "a", Index: 0, Label: "x", Value: 1},
+		{Series: "a", Index: 1, Label: "y", Value: 2},
+		{Series: "b", Index: 0, Label: "", Value: 3},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d", len(rows), len(want))
+	}
+	for i, got := range rows {
+		if *got != *want[i] {
+			t.Errorf("row %d = %+v, want %+v", i, *got, *want[i])
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package export
+
+import (
+	"fmt"
+
+	"github.com/xuri/excelize/v2"
+
+	"preload/internal/preload"
+)
+
+// WriteXLSX writes one sheet per series (raw values) plus a "Summary"
+// sheet of the computed statistics to an XLSX workbook at path.
+func WriteXLSX(result preload.Result, path string) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	for i, s := range result.Job.Series {
+		sheet := sheetName(s.Name)
+		if i == 0 {
+			if err := f.SetSheetName("Sheet1", sheet); err != nil {
+				return fmt.Errorf("name sheet %q: %w", sheet, err)
+			}
+		} else {
+			if _, err := f.NewSheet(sheet); err != nil {
+				return fmt.Errorf("create sheet %q: %w", sheet, err)
+			}
+		}
+
+		if err := f.SetCellValue(sheet, "A1", "label"); err != nil {
+			return fmt.Errorf("write sheet %q header: %w", sheet, err)
+		}
+		if err := f.SetCellValue(sheet, "B1", "value"); err != nil {
+			return fmt.Errorf("write sheet %q header: %w", sheet, err)
+		}
+		for row, v := range s.Values {
+			label := ""
+			if row < len(s.Labels) {
+				label = s.Labels[row]
+			}
+			if err := f.SetCellValue(sheet, fmt.Sprintf("A%d", row+2), label); err != nil {
+				return fmt.Errorf("write sheet %q row %d: %w", sheet, row, err)
+			}
+			if err := f.SetCellValue(sheet, fmt.Sprintf("B%d", row+2), v); err != nil {
+				return fmt.Errorf("write sheet %q row %d: %w", sheet, row, err)
+			}
+		}
+	}
+
+	if _, err := f.NewSheet("Summary"); err != nil {
+		return fmt.Errorf("create summary sheet: %w", err)
+	}
+	headers := []string{"series", "min", "max", "mean", "median", "stddev", "p25", "p50", "p90"}
+	for col, h := range headers {
+		cell, err := excelize.CoordinatesToCellName(col+1, 1)
+		if err != nil {
+			return fmt.Errorf("summary header cell: %w", err)
+		}
+		if err := f.SetCellValue("Summary", cell, h); err != nil {
+			return fmt.Errorf("write summary header: %w", err)
+		}
+	}
+	row := 2
+	for _, s := range result.Job.Series {
+		st := result.Summary[s.Name]
+		values := []interface{}{
+			s.Name, st.Min, st.Max, st.Mean, st.Median, st.StdDev,
+			st.Quantiles["p25"], st.Quantiles["p50"], st.Quantiles["p90"],
+		}
+		for col, v := range values {
+			cell, err := excelize.CoordinatesToCellName(col+1, row)
+			if err != nil {
+				return fmt.Errorf("summary cell for series %q: %w", s.Name, err)
+			}
+			if err := f.SetCellValue("Summary", cell, v); err != nil {
+				return fmt.Errorf("write summary row for series %q: %w", s.Name, err)
+			}
+		}
+		row++
+	}
+
+	return f.SaveAs(path)
+}
+
+func sheetName(name string) string {
+	if name == "" {
+		return "series"
+	}
+	if len(name) > 31 {
+		return name[:31]
+	}
+	return name
+}
@@ -0,0 +1,83 @@
+package export
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+
+	"preload/internal/preload"
+)
+
+func TestWriteXLSX(t *testing.T) {
+	result := preload.Result{
+		Job: preload.Job{
+			Name: "demo",
+			Series: []preload.Series{
+				{Name: "a", Values: []float64{1, 2}},
+				{Name: "b", Values: []float64{3}},
+			},
+		},
+		Summary: map[string]preload.Stats{
+			"a": {Min: 1, Max: 2, Mean: 1.5, Median: 1.5, StdDev: 0.5, Quantiles: map[string]float64{"p25": 1, "p50": 1.5, "p90": 2}},
+			"b": {Min: 3, Max: 3, Mean: 3, Median: 3, StdDev: 0, Quantiles: map[string]float64{"p25": 3, "p50": 3, "p90": 3}},
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "out.xlsx")
+	if err := WriteXLSX(result, path); err != nil {
+		t.Fatalf("WriteXLSX returned error: %v", err)
+	}
+
+	f, err := excelize.OpenFile(path)
+	if err != nil {
+		t.Fatalf("open written xlsx: %v", err)
+	}
+	defer f.Close()
+
+	for _, want := range []struct {
+		sheet string
+		cell  string
+		value string
+	}{
+		{"a", "A1", "label"},
+		{"a", "B2", "1"},
+		{"a", "B3", "2"},
+		{"b", "B2", "3"},
+	} {
+		got, err := f.GetCellValue(want.sheet, want.cell)
+		if err != nil {
+			t.Fatalf("GetCellValue(%q, %q): %v", want.sheet, want.cell, err)
+		}
+		if got != want.value {
+			t.Errorf("sheet %q cell %q = %q, want %q", want.sheet, want.cell, got, want.value)
+		}
+	}
+
+	summarySeries, err := f.GetCellValue("Summary", "A2")
+	if err != nil {
+		t.Fatalf("GetCellValue(Summary, A2): %v", err)
+	}
+	if summarySeries != "a" {
+		t.Errorf("Summary A2 = %q, want %q", summarySeries, "a")
+	}
+}
+
+func TestSheetName(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty falls back", "", "series"},
+		{"short name kept as-is", "latency", "latency"},
+		{"long name truncated to 31 chars", "this-series-name-is-way-too-long-for-excel", "this-series-name-is-way-too-lon"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := sheetName(tc.in); got != tc.want {
+				t.Errorf("sheetName(%q) = %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package httpapi
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"preload/internal/export"
+	"preload/internal/httpx"
+	"preload/internal/preload"
+	"preload/sysprobe"
+)
+
+var errJobNotFound = errors.New("job not found")
+
+// jobStatus is the lifecycle of a submitted job.
+type jobStatus string
+
+const (
+	jobPending jobStatus = "pending"
+	jobReady   jobStatus = "ready"
+	jobFailed  jobStatus = "failed"
+)
+
+type jobRecord struct {
+	ID       string    `json:"id"`
+	Status   jobStatus `json:"status"`
+	Error    string    `json:"error,omitempty"`
+	CSVPath  string    `json:"csv_path,omitempty"`
+	XLSXPath string    `json:"xlsx_path,omitempty"`
+}
+
+// jobStore tracks submitted jobs in memory, keyed by ID.
+type jobStore struct {
+	mu   sync.RWMutex
+	byID map[string]*jobRecord
+}
+
+func newJobStore() *jobStore {
+	return &jobStore{byID: make(map[string]*jobRecord)}
+}
+
+func (s *jobStore) put(r *jobRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.byID[r.ID] = r
+}
+
+func (s *jobStore) get(id string) (*jobRecord, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	r, ok := s.byID[id]
+	return r, ok
+}
+
+// submitJob accepts a preload.Job, runs it in the background, and writes
+// its CSV/XLSX exports under the server's output directory once done.
+func (s *Server) submitJob(w http.ResponseWriter, r *http.Request) {
+	var job preload.Job
+	if err := httpx.DecodeAndValidate(r, &job); err != nil {
+		httpx.RespondError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	id := uuid.NewString()
+	record := &jobRecord{ID: id, Status: jobPending}
+	s.jobs.put(record)
+
+	go s.runJob(id, job)
+
+	httpx.Respond(w, http.StatusAccepted, record)
+}
+
+func (s *Server) runJob(id string, job preload.Job) {
+	snap, err := sysprobe.Sample()
+	if err != nil {
+		s.failJob(id, err)
+		return
+	}
+	plan := preload.PlanFor(preload.TargetFor(job), snap)
+
+	result, err := preload.Run(job, plan)
+	if err != nil {
+		s.failJob(id, err)
+		return
+	}
+
+	csvPath := filepath.Join(s.outputDir, id+".csv")
+	if err := export.WriteCSV(result, csvPath); err != nil {
+		s.failJob(id, err)
+		return
+	}
+	xlsxPath := filepath.Join(s.outputDir, id+".xlsx")
+	if err := export.WriteXLSX(result, xlsxPath); err != nil {
+		s.failJob(id, err)
+		return
+	}
+
+	s.jobs.put(&jobRecord{ID: id, Status: jobReady, CSVPath: csvPath, XLSXPath: xlsxPath})
+}
+
+func (s *Server) failJob(id string, err error) {
+	logrus.WithError(err).WithField("job_id", id).Error("preload job failed")
+	s.jobs.put(&jobRecord{ID: id, Status: jobFailed, Error: err.Error()})
+}
+
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request) {
+	record, ok := s.jobs.get(mux.Vars(r)["id"])
+	if !ok {
+		httpx.RespondError(w, http.StatusNotFound, errJobNotFound)
+		return
+	}
+	httpx.Respond(w, http.StatusOK, record)
+}
@@ -0,0 +1,43 @@
+// Package httpapi exposes the preload job runner over HTTP: submitting
+// jobs, polling their readiness, and reporting the host's sizing snapshot.
+package httpapi
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"preload/internal/httpx"
+)
+
+// Server wires the preload HTTP routes onto a gorilla/mux router.
+type Server struct {
+	router    *mux.Router
+	jobs      *jobStore
+	outputDir string
+}
+
+// NewServer builds a Server that writes job exports under outputDir.
+func NewServer(outputDir string) *Server {
+	s := &Server{
+		router:    mux.NewRouter(),
+		jobs:      newJobStore(),
+		outputDir: outputDir,
+	}
+	s.routes()
+	return s
+}
+
+// Handler returns the server's http.Handler, e.g. to serve it or mount it
+// in a test.
+func (s *Server) Handler() http.Handler {
+	return s.router
+}
+
+func (s *Server) routes() {
+	s.router.Use(httpx.WithRequestLogger)
+	s.router.Use(httpx.WithRecovery)
+	s.router.HandleFunc("/jobs", s.submitJob).Methods(http.MethodPost)
+	s.router.HandleFunc("/jobs/{id}", s.getJob).Methods(http.MethodGet)
+	s.router.HandleFunc("/sysinfo", s.sysinfo).Methods(http.MethodGet)
+}
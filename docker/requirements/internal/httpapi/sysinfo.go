@@ -0,0 +1,20 @@
+package httpapi
+
+import (
+	"net/http"
+
+	"preload/internal/httpx"
+	"preload/sysprobe"
+)
+
+// sysinfo reports a fresh sysprobe.Snapshot so operators can confirm
+// sizing decisions without shelling into the box.
+func (s *Server) sysinfo(w http.ResponseWriter, r *http.Request) {
+	snap, err := sysprobe.Sample()
+	if err != nil {
+		httpx.Logger(r.Context()).WithError(err).Error("failed to sample host")
+		httpx.RespondError(w, http.StatusInternalServerError, err)
+		return
+	}
+	httpx.Respond(w, http.StatusOK, snap)
+}
@@ -0,0 +1,35 @@
+package httpx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Validator is implemented by request bodies that can check their own
+// invariants after being decoded.
+type Validator interface {
+	Validate() error
+}
+
+// Decode JSON-decodes r's body into dst.
+func Decode(r *http.Request, dst interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		return fmt.Errorf("decode request body: %w", err)
+	}
+	return nil
+}
+
+// DecodeAndValidate decodes r's body into dst and, if dst implements
+// Validator, runs its validation.
+func DecodeAndValidate(r *http.Request, dst interface{}) error {
+	if err := Decode(r, dst); err != nil {
+		return err
+	}
+	if v, ok := dst.(Validator); ok {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
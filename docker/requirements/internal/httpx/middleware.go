@@ -0,0 +1,61 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+type contextKey int
+
+const loggerKey contextKey = iota
+
+var errPanicRecovered = errors.New("internal server error")
+
+// Logger returns the request-scoped logger attached by WithRequestLogger,
+// falling back to the standard logger if none is present.
+func Logger(ctx context.Context) *logrus.Entry {
+	if entry, ok := ctx.Value(loggerKey).(*logrus.Entry); ok {
+		return entry
+	}
+	return logrus.NewEntry(logrus.StandardLogger())
+}
+
+// WithRequestLogger attaches a request-scoped logger (tagged with a
+// generated request ID, method, and path) to each request's context, and
+// logs the outcome once the handler returns.
+func WithRequestLogger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		entry := logrus.WithFields(logrus.Fields{
+			"request_id": uuid.NewString(),
+			"method":     r.Method,
+			"path":       r.URL.Path,
+		})
+
+		ctx := context.WithValue(r.Context(), loggerKey, entry)
+		next.ServeHTTP(w, r.WithContext(ctx))
+
+		entry.WithField("duration", time.Since(start)).Info("handled request")
+	})
+}
+
+// WithRecovery recovers from a panic in next, logs it with the
+// request-scoped logger, and responds with a generic 500 instead of
+// letting the panic unwind into net/http's per-connection recover (which
+// drops the connection without a response body).
+func WithRecovery(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				Logger(r.Context()).WithField("panic", rec).Error("recovered from panic")
+				RespondError(w, http.StatusInternalServerError, errPanicRecovered)
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
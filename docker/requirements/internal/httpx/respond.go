@@ -0,0 +1,29 @@
+// Package httpx provides the small set of helpers every net/http handler
+// in this module needs: decoding and validating a JSON body, writing a
+// JSON response, and a request-scoped logger.
+package httpx
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Respond writes body to w as JSON with the given status code.
+func Respond(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body == nil {
+		return
+	}
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logrus.WithError(err).Error("httpx: failed to encode response")
+	}
+}
+
+// RespondError writes err as a {"error": "..."} JSON body with the given
+// status code.
+func RespondError(w http.ResponseWriter, status int, err error) {
+	Respond(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,93 @@
+// Package itest also carries this file: a fast, no-Docker companion to
+// preload_test.go's container-based pipeline test. It drives the same
+// preload.Run -> export/charts pipeline in-process, against the same job
+// shapes, so a regression here is caught by the default "go test ./..."
+// suite rather than only by "go test -tags integration ./...".
+package itest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gocarina/gocsv"
+	"github.com/xuri/excelize/v2"
+
+	"preload/charts"
+	"preload/internal/export"
+	"preload/internal/preload"
+)
+
+// flatRow mirrors the shape internal/export writes; duplicated from
+// preload_test.go since that file is integration-build-tagged and so
+// unavailable to this one.
+type flatRow struct {
+	Series string  `csv:"series"`
+	Index  int     `csv:"index"`
+	Label  string  `csv:"label"`
+	Value  float64 `csv:"value"`
+}
+
+func TestPreloadPipelineNoDocker(t *testing.T) {
+	jobs := []preload.Job{
+		{Name: "latency", Series: []preload.Series{
+			{Name: "p99", Values: []float64{12, 14, 9, 18, 21}},
+		}},
+		{Name: "capacity", Series: []preload.Series{
+			{Name: "cpu", Values: []float64{1, 2, 3}},
+			{Name: "mem", Values: []float64{4, 5, 6}},
+		}},
+	}
+
+	for _, job := range jobs {
+		t.Run(job.Name, func(t *testing.T) {
+			if err := job.Validate(); err != nil {
+				t.Fatalf("validate: %v", err)
+			}
+
+			result, err := preload.Run(job, preload.Plan{})
+			if err != nil {
+				t.Fatalf("run: %v", err)
+			}
+
+			dir := t.TempDir()
+			csvPath := filepath.Join(dir, job.Name+".csv")
+			if err := export.WriteCSV(result, csvPath); err != nil {
+				t.Fatalf("write csv: %v", err)
+			}
+			f, err := os.Open(csvPath)
+			if err != nil {
+				t.Fatalf("open written csv: %v", err)
+			}
+			defer f.Close()
+			var rows []*flatRow
+			if err := gocsv.UnmarshalFile(f, &rows); err != nil {
+				t.Fatalf("parse csv: %v", err)
+			}
+			if len(rows) == 0 {
+				t.Fatal("expected at least one csv row")
+			}
+
+			xlsxPath := filepath.Join(dir, job.Name+".xlsx")
+			if err := export.WriteXLSX(result, xlsxPath); err != nil {
+				t.Fatalf("write xlsx: %v", err)
+			}
+			wb, err := excelize.OpenFile(xlsxPath)
+			if err != nil {
+				t.Fatalf("open xlsx: %v", err)
+			}
+			defer wb.Close()
+			summaryRows, err := wb.GetRows("Summary")
+			if err != nil {
+				t.Fatalf("read summary sheet: %v", err)
+			}
+			if len(summaryRows) != len(job.Series)+1 {
+				t.Fatalf("summary sheet has %d rows, want %d", len(summaryRows), len(job.Series)+1)
+			}
+
+			if _, err := charts.Render(result, charts.Options{Title: job.Name}); err != nil {
+				t.Fatalf("render chart: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+//go:build integration
+
+// Package itest exercises the whole preload pipeline — HTTP submission,
+// background processing, and CSV/XLSX export — against a built preload
+// image. Run with:
+//
+//	go test -tags integration ./internal/itest/...
+//
+// A MinIO container isn't started here: the server has no artifact-upload
+// path yet, so there is nothing to assert against it. Add one back once
+// that path exists.
+package itest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gocarina/gocsv"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/xuri/excelize/v2"
+)
+
+// csvRow mirrors the shape internal/export writes, so gocsv can parse the
+// exported CSV back into rows without depending on that unexported type.
+type csvRow struct {
+	Series string  `csv:"series"`
+	Index  int     `csv:"index"`
+	Label  string  `csv:"label"`
+	Value  float64 `csv:"value"`
+}
+
+// jobResponse mirrors httpapi's jobRecord JSON shape.
+type jobResponse struct {
+	ID       string `json:"id"`
+	Status   string `json:"status"`
+	Error    string `json:"error"`
+	CSVPath  string `json:"csv_path"`
+	XLSXPath string `json:"xlsx_path"`
+}
+
+func TestPreloadPipeline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	app, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			FromDockerfile: testcontainers.FromDockerfile{
+				Context:    "../..",
+				Dockerfile: "Dockerfile",
+			},
+			ExposedPorts: []string{"8080/tcp"},
+			Cmd:          []string{"serve", "--addr", ":8080", "--output-dir", "/data"},
+			WaitingFor:   wait.ForHTTP("/sysinfo").WithPort("8080/tcp"),
+		},
+		Started: true,
+	})
+	if err != nil {
+		t.Fatalf("start preload: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := app.Terminate(ctx); err != nil {
+			t.Logf("terminate preload: %v", err)
+		}
+	})
+
+	host, err := app.Host(ctx)
+	if err != nil {
+		t.Fatalf("container host: %v", err)
+	}
+	port, err := app.MappedPort(ctx, "8080/tcp")
+	if err != nil {
+		t.Fatalf("container port: %v", err)
+	}
+	baseURL := fmt.Sprintf("http://%s:%s", host, port.Port())
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{
+			name: "single series",
+			body: `{"name":"latency","series":[{"name":"p99","values":[12,14,9,18,21]}]}`,
+		},
+		{
+			name: "multi series",
+			body: `{"name":"capacity","series":[{"name":"cpu","values":[1,2,3]},{"name":"mem","values":[4,5,6]}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Post(baseURL+"/jobs", "application/json", bytes.NewBufferString(tt.body))
+			if err != nil {
+				t.Fatalf("submit job: %v", err)
+			}
+			var submitted jobResponse
+			err = json.NewDecoder(resp.Body).Decode(&submitted)
+			resp.Body.Close()
+			if err != nil {
+				t.Fatalf("decode submit response: %v", err)
+			}
+
+			job := waitForJobReady(t, baseURL, submitted.ID)
+
+			csvData := copyFromContainer(t, ctx, app, job.CSVPath)
+			var rows []*csvRow
+			if err := gocsv.UnmarshalBytes(csvData, &rows); err != nil {
+				t.Fatalf("parse csv: %v", err)
+			}
+			if len(rows) == 0 {
+				t.Fatal("expected at least one csv row")
+			}
+
+			xlsxData := copyFromContainer(t, ctx, app, job.XLSXPath)
+			wb, err := excelize.OpenReader(bytes.NewReader(xlsxData))
+			if err != nil {
+				t.Fatalf("open xlsx: %v", err)
+			}
+			defer wb.Close()
+			summaryRows, err := wb.GetRows("Summary")
+			if err != nil {
+				t.Fatalf("read summary sheet: %v", err)
+			}
+			if len(summaryRows) < 2 {
+				t.Fatalf("expected a header row plus at least one summary row, got %d", len(summaryRows))
+			}
+		})
+	}
+}
+
+func waitForJobReady(t *testing.T, baseURL, id string) jobResponse {
+	t.Helper()
+	deadline := time.Now().Add(30 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := http.Get(fmt.Sprintf("%s/jobs/%s", baseURL, id))
+		if err != nil {
+			t.Fatalf("poll job: %v", err)
+		}
+		var job jobResponse
+		err = json.NewDecoder(resp.Body).Decode(&job)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatalf("decode job: %v", err)
+		}
+		switch job.Status {
+		case "ready":
+			return job
+		case "failed":
+			t.Fatalf("job failed: %s", job.Error)
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	t.Fatalf("job %s did not become ready in time", id)
+	return jobResponse{}
+}
+
+func copyFromContainer(t *testing.T, ctx context.Context, c testcontainers.Container, path string) []byte {
+	t.Helper()
+	reader, err := c.CopyFileFromContainer(ctx, path)
+	if err != nil {
+		t.Fatalf("copy %s from container: %v", path, err)
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("read %s: %v", path, err)
+	}
+	return data
+}
@@ -0,0 +1,182 @@
+// Package preload holds the domain types for a preload job: the input
+// series it operates on and the summary statistics computed from them.
+package preload
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/montanaflynn/stats"
+)
+
+// Series is a single named sequence of observations, e.g. one column of a
+// capacity/latency measurement. Labels, when present, name each value: they
+// fill the label column of the CSV/XLSX exports and, when there is exactly
+// one per value, the x-axis of the line and scatter charts; otherwise values
+// are treated as an ordered, equally-spaced sequence.
+type Series struct {
+	Name   string    `json:"name"`
+	Labels []string  `json:"labels,omitempty"`
+	Values []float64 `json:"values"`
+}
+
+// Job is a named set of series to analyze together.
+type Job struct {
+	Name   string   `json:"name"`
+	Series []Series `json:"series"`
+}
+
+// unsafeSheetNameChars are the characters Excel forbids in a sheet name.
+// Series names become XLSX sheet names (see export.WriteXLSX), so they're
+// rejected here too.
+const unsafeSheetNameChars = `:\/?*[]`
+
+// Validate checks that job has at least one series and that every series
+// has a name and at least one value. It also rejects series names that
+// collide once truncated to Excel's 31-character sheet-name limit, or that
+// contain a character Excel forbids in a sheet name, since both would
+// silently corrupt the XLSX export. It satisfies httpx.Validator so a Job
+// decoded from an HTTP request body validates itself.
+func (j Job) Validate() error {
+	if len(j.Series) == 0 {
+		return fmt.Errorf("job must have at least one series")
+	}
+	seen := make(map[string]bool, len(j.Series))
+	for i, s := range j.Series {
+		if s.Name == "" {
+			return fmt.Errorf("series %d: name is required", i)
+		}
+		if len(s.Values) == 0 {
+			return fmt.Errorf("series %q: at least one value is required", s.Name)
+		}
+		if strings.ContainsAny(s.Name, unsafeSheetNameChars) {
+			return fmt.Errorf("series %q: name must not contain any of %q", s.Name, unsafeSheetNameChars)
+		}
+		key := s.Name
+		if len(key) > 31 {
+			key = key[:31]
+		}
+		if seen[key] {
+			return fmt.Errorf("series %q: name collides with another series within the first 31 characters", s.Name)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Stats holds the summary statistics computed for a single series.
+type Stats struct {
+	Min, Max, Mean, Median, StdDev float64
+	Quantiles                      map[string]float64
+}
+
+// Result is the output of running a Job: the original job plus the
+// per-series statistics.
+type Result struct {
+	Job     Job
+	Summary map[string]Stats
+}
+
+// Run computes summary statistics for every series in job, sized by plan:
+// series are processed in batches of plan.BatchSize, each batch fanned out
+// across plan.Workers goroutines. Callers that don't have a host snapshot
+// to size against can pass a single-worker, single-batch Plan.
+func Run(job Job, plan Plan) (Result, error) {
+	workers := plan.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	batchSize := int(plan.BatchSize)
+	if batchSize < 1 {
+		batchSize = len(job.Series)
+	}
+
+	summary := make(map[string]Stats, len(job.Series))
+	for start := 0; start < len(job.Series); start += batchSize {
+		end := start + batchSize
+		if end > len(job.Series) {
+			end = len(job.Series)
+		}
+		batch := job.Series[start:end]
+
+		var (
+			mu   sync.Mutex
+			wg   sync.WaitGroup
+			sem  = make(chan struct{}, workers)
+			errs = make(chan error, len(batch))
+		)
+		for _, s := range batch {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(s Series) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				st, err := summarize(s.Values)
+				if err != nil {
+					errs <- fmt.Errorf("summarize series %q: %w", s.Name, err)
+					return
+				}
+				mu.Lock()
+				summary[s.Name] = st
+				mu.Unlock()
+			}(s)
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			if err != nil {
+				return Result{}, err
+			}
+		}
+	}
+
+	return Result{Job: job, Summary: summary}, nil
+}
+
+func summarize(values []float64) (Stats, error) {
+	data := stats.Float64Data(values)
+
+	min, err := data.Min()
+	if err != nil {
+		return Stats{}, err
+	}
+	max, err := data.Max()
+	if err != nil {
+		return Stats{}, err
+	}
+	mean, err := data.Mean()
+	if err != nil {
+		return Stats{}, err
+	}
+	median, err := data.Median()
+	if err != nil {
+		return Stats{}, err
+	}
+	stdDev, err := data.StandardDeviation()
+	if err != nil {
+		return Stats{}, err
+	}
+
+	quantiles := make(map[string]float64, 3)
+	for _, p := range []float64{25, 50, 90} {
+		// PercentileNearestRank, not Percentile: Percentile's interpolation
+		// returns BoundsErr for any 2- or 3-value series (the index it
+		// computes lands <=1 and non-integer), which would otherwise fail
+		// perfectly valid small jobs.
+		q, err := data.PercentileNearestRank(p)
+		if err != nil {
+			return Stats{}, err
+		}
+		quantiles[fmt.Sprintf("p%g", p)] = q
+	}
+
+	return Stats{
+		Min:       min,
+		Max:       max,
+		Mean:      mean,
+		Median:    median,
+		StdDev:    stdDev,
+		Quantiles: quantiles,
+	}, nil
+}
@@ -0,0 +1,126 @@
+package preload
+
+import "testing"
+
+func TestValidateRejectsEmptyJob(t *testing.T) {
+	if err := (Job{}).Validate(); err == nil {
+		t.Fatal("expected an error for a job with no series")
+	}
+}
+
+func TestValidateRejectsMissingNameOrValues(t *testing.T) {
+	cases := []struct {
+		name string
+		job  Job
+	}{
+		{"missing name", Job{Series: []Series{{Values: []float64{1}}}}},
+		{"missing values", Job{Series: []Series{{Name: "a"}}}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.job.Validate(); err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+		})
+	}
+}
+
+func TestValidateRejectsUnsafeSheetNameChars(t *testing.T) {
+	job := Job{Series: []Series{{Name: "a/b", Values: []float64{1}}}}
+	if err := job.Validate(); err == nil {
+		t.Fatal("expected an error for a name containing an unsafe sheet-name character")
+	}
+}
+
+func TestValidateRejectsDuplicateNames(t *testing.T) {
+	job := Job{Series: []Series{
+		{Name: "a", Values: []float64{1}},
+		{Name: "a", Values: []float64{2}},
+	}}
+	if err := job.Validate(); err == nil {
+		t.Fatal("expected an error for duplicate series names")
+	}
+}
+
+func TestValidateRejectsNamesCollidingAfterTruncation(t *testing.T) {
+	base := "this-series-name-is-exactly-long-enough-to-collide"
+	job := Job{Series: []Series{
+		{Name: base + "-one", Values: []float64{1}},
+		{Name: base + "-two", Values: []float64{2}},
+	}}
+	if err := job.Validate(); err == nil {
+		t.Fatal("expected an error for names that collide within the first 31 characters")
+	}
+}
+
+func TestValidateAcceptsDistinctSafeNames(t *testing.T) {
+	job := Job{Series: []Series{
+		{Name: "a", Values: []float64{1}},
+		{Name: "b", Values: []float64{2}},
+	}}
+	if err := job.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRunComputesStatsForEverySeries(t *testing.T) {
+	job := Job{Series: []Series{
+		{Name: "a", Values: []float64{1, 2, 3, 4}},
+		{Name: "b", Values: []float64{4, 5, 6, 7}},
+		{Name: "c", Values: []float64{7, 8, 9, 10}},
+	}}
+
+	result, err := Run(job, Plan{Workers: 2, BatchSize: 2})
+	if err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if len(result.Summary) != len(job.Series) {
+		t.Fatalf("got %d summaries, want %d", len(result.Summary), len(job.Series))
+	}
+	if got := result.Summary["b"].Mean; got != 5.5 {
+		t.Errorf("series b mean = %v, want 5.5", got)
+	}
+}
+
+func TestRunWithZeroPlanDefaultsToOneWorkerOneBatch(t *testing.T) {
+	job := Job{Series: []Series{{Name: "a", Values: []float64{1, 2, 3, 4}}}}
+
+	result, err := Run(job, Plan{})
+	if err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if got := result.Summary["a"].Mean; got != 2.5 {
+		t.Errorf("series a mean = %v, want 2.5", got)
+	}
+}
+
+func TestSummarizeHandlesSmallSeries(t *testing.T) {
+	// Regression test: stats.Float64Data.Percentile returns BoundsErr for
+	// any 2- or 3-value series, which would otherwise fail a perfectly
+	// valid small job.
+	cases := []struct {
+		name   string
+		values []float64
+	}{
+		{"two values", []float64{1, 2}},
+		{"three values", []float64{1, 2, 3}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := summarize(tc.values); err != nil {
+				t.Fatalf("summarize(%v) returned error: %v", tc.values, err)
+			}
+		})
+	}
+}
+
+func TestRunHandlesSmallSeries(t *testing.T) {
+	job := Job{Series: []Series{
+		{Name: "a", Values: []float64{1, 2}},
+		{Name: "b", Values: []float64{1, 2, 3}},
+	}}
+
+	if _, err := Run(job, Plan{}); err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+}
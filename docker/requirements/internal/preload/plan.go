@@ -0,0 +1,93 @@
+package preload
+
+import (
+	"math"
+
+	"github.com/shopspring/decimal"
+
+	"preload/sysprobe"
+)
+
+// memorySafetyMargin is the fraction of available memory a Plan is allowed
+// to budget for, leaving headroom for the rest of the system.
+const memorySafetyMargin = 0.8
+
+// Target describes the work a Plan is being sized for.
+type Target struct {
+	// TotalUnits is the number of items the job needs to process.
+	TotalUnits int64
+	// BytesPerUnit estimates the memory cost of holding one unit in memory.
+	BytesPerUnit int64
+}
+
+// bytesPerFloat64 is the memory cost of a single series value, used to
+// translate a series' length into a byte estimate for TargetFor.
+const bytesPerFloat64 = 8
+
+// TargetFor estimates the Target a job represents: one unit of work per
+// series, each sized by the average memory footprint of the job's series.
+func TargetFor(job Job) Target {
+	var totalValues int64
+	for _, s := range job.Series {
+		totalValues += int64(len(s.Values))
+	}
+
+	bytesPerUnit := int64(bytesPerFloat64)
+	if len(job.Series) > 0 {
+		bytesPerUnit = (totalValues / int64(len(job.Series))) * bytesPerFloat64
+		if bytesPerUnit < bytesPerFloat64 {
+			bytesPerUnit = bytesPerFloat64
+		}
+	}
+
+	return Target{TotalUnits: int64(len(job.Series)), BytesPerUnit: bytesPerUnit}
+}
+
+// Plan is a sizing recommendation for a job: how many workers to run and
+// how large a batch each one should pull, given a sysprobe.Snapshot of the
+// host it will run on.
+type Plan struct {
+	Workers      int             `json:"workers"`
+	BatchSize    int64           `json:"batch_size"`
+	MemoryBudget decimal.Decimal `json:"memory_budget_bytes"`
+}
+
+// PlanFor sizes a job for target given snap, a snapshot of the resources
+// available on the host. Worker count is capped by the CPU cgroup quota
+// (if any) and batch size by the memory budget left after the safety
+// margin.
+func PlanFor(target Target, snap sysprobe.Snapshot) Plan {
+	workers := snap.CPUCount
+	if snap.Cgroup != nil && snap.Cgroup.CPUQuota > 0 {
+		if quota := int(math.Ceil(snap.Cgroup.CPUQuota)); quota < workers {
+			workers = quota
+		}
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	availBytes := snap.MemAvail
+	if snap.Cgroup != nil && snap.Cgroup.MemoryLimitBytes > 0 && uint64(snap.Cgroup.MemoryLimitBytes) < availBytes {
+		availBytes = uint64(snap.Cgroup.MemoryLimitBytes)
+	}
+
+	budget := decimal.NewFromInt(int64(availBytes)).Mul(decimal.NewFromFloat(memorySafetyMargin))
+
+	batchSize := target.TotalUnits
+	if target.BytesPerUnit > 0 {
+		perWorker := budget.Div(decimal.NewFromInt(int64(workers)))
+		maxUnits := perWorker.Div(decimal.NewFromInt(target.BytesPerUnit)).IntPart()
+		if maxUnits < 1 {
+			maxUnits = 1
+		}
+		if maxUnits < batchSize {
+			batchSize = maxUnits
+		}
+	}
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	return Plan{Workers: workers, BatchSize: batchSize, MemoryBudget: budget}
+}
@@ -0,0 +1,86 @@
+package preload
+
+import (
+	"testing"
+
+	"preload/sysprobe"
+)
+
+func TestTargetFor(t *testing.T) {
+	job := Job{Series: []Series{
+		{Name: "a", Values: []float64{1, 2, 3}},
+		{Name: "b", Values: []float64{1, 2, 3, 4, 5}},
+	}}
+
+	target := TargetFor(job)
+	if target.TotalUnits != 2 {
+		t.Errorf("TotalUnits = %d, want 2", target.TotalUnits)
+	}
+	// average series length is (3+5)/2 = 4 values, 8 bytes each.
+	if want := int64(4 * bytesPerFloat64); target.BytesPerUnit != want {
+		t.Errorf("BytesPerUnit = %d, want %d", target.BytesPerUnit, want)
+	}
+}
+
+func TestTargetForNoSeries(t *testing.T) {
+	target := TargetFor(Job{})
+	if target.TotalUnits != 0 {
+		t.Errorf("TotalUnits = %d, want 0", target.TotalUnits)
+	}
+	if target.BytesPerUnit != bytesPerFloat64 {
+		t.Errorf("BytesPerUnit = %d, want %d", target.BytesPerUnit, bytesPerFloat64)
+	}
+}
+
+func TestPlanForCapsWorkersByCgroupQuota(t *testing.T) {
+	snap := sysprobe.Snapshot{
+		CPUCount: 8,
+		MemAvail: 1000,
+		Cgroup:   &sysprobe.Cgroup{CPUQuota: 2, MemoryLimitBytes: -1},
+	}
+
+	plan := PlanFor(Target{TotalUnits: 10, BytesPerUnit: 1}, snap)
+	if plan.Workers != 2 {
+		t.Errorf("Workers = %d, want 2 (capped by cgroup quota)", plan.Workers)
+	}
+}
+
+func TestPlanForKeepsAtLeastOneWorker(t *testing.T) {
+	snap := sysprobe.Snapshot{CPUCount: 4, MemAvail: 1000}
+	plan := PlanFor(Target{TotalUnits: 10, BytesPerUnit: 1}, snap)
+	if plan.Workers < 1 {
+		t.Errorf("Workers = %d, want >= 1", plan.Workers)
+	}
+}
+
+func TestPlanForCapsBatchSizeByMemoryBudget(t *testing.T) {
+	snap := sysprobe.Snapshot{CPUCount: 1, MemAvail: 100}
+	plan := PlanFor(Target{TotalUnits: 1000, BytesPerUnit: 10}, snap)
+
+	// budget = 100 * 0.8 = 80 bytes / 1 worker / 10 bytes-per-unit = 8 units.
+	if plan.BatchSize != 8 {
+		t.Errorf("BatchSize = %d, want 8", plan.BatchSize)
+	}
+}
+
+func TestPlanForUsesCgroupMemoryLimitWhenTighter(t *testing.T) {
+	snap := sysprobe.Snapshot{
+		CPUCount: 1,
+		MemAvail: 10_000,
+		Cgroup:   &sysprobe.Cgroup{CPUQuota: -1, MemoryLimitBytes: 100},
+	}
+	plan := PlanFor(Target{TotalUnits: 1000, BytesPerUnit: 10}, snap)
+
+	// budget should be derived from the tighter cgroup limit (100), not MemAvail.
+	if plan.BatchSize != 8 {
+		t.Errorf("BatchSize = %d, want 8 (sized from cgroup memory limit)", plan.BatchSize)
+	}
+}
+
+func TestPlanForNeverReturnsZeroBatchSize(t *testing.T) {
+	snap := sysprobe.Snapshot{CPUCount: 1, MemAvail: 0}
+	plan := PlanFor(Target{TotalUnits: 5, BytesPerUnit: 1}, snap)
+	if plan.BatchSize < 1 {
+		t.Errorf("BatchSize = %d, want >= 1", plan.BatchSize)
+	}
+}
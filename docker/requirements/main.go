@@ -0,0 +1,9 @@
+// Command preload computes capacity/preload sizing for a workload and
+// exports the results as CSV, XLSX, and/or an interactive HTML report.
+package main
+
+import "preload/cmd"
+
+func main() {
+	cmd.Execute()
+}
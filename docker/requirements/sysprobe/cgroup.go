@@ -0,0 +1,80 @@
+package sysprobe
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// cgroupRoot is where the cgroup pseudo-filesystem is mounted. It's a var,
+// not a const, so tests can point it at a fixture directory.
+var cgroupRoot = "/sys/fs/cgroup"
+
+// readCgroup detects cgroup v2 (unified) or v1 memory/CPU limits under
+// cgroupRoot. It returns nil when neither is present, e.g. when not
+// running in a container.
+func readCgroup() *Cgroup {
+	if c := readCgroupV2(cgroupRoot); c != nil {
+		return c
+	}
+	return readCgroupV1(cgroupRoot)
+}
+
+func readCgroupV2(root string) *Cgroup {
+	mem, ok := readCgroupInt64(filepath.Join(root, "memory.max"), "max")
+	if !ok {
+		return nil
+	}
+
+	raw, err := os.ReadFile(filepath.Join(root, "cpu.max"))
+	if err != nil {
+		return &Cgroup{MemoryLimitBytes: mem, CPUQuota: -1}
+	}
+	fields := strings.Fields(strings.TrimSpace(string(raw)))
+	quota := -1.0
+	if len(fields) == 2 && fields[0] != "max" {
+		if q, err := strconv.ParseFloat(fields[0], 64); err == nil {
+			if p, err := strconv.ParseFloat(fields[1], 64); err == nil && p > 0 {
+				quota = q / p
+			}
+		}
+	}
+
+	return &Cgroup{MemoryLimitBytes: mem, CPUQuota: quota}
+}
+
+func readCgroupV1(root string) *Cgroup {
+	mem, ok := readCgroupInt64(filepath.Join(root, "memory", "memory.limit_in_bytes"), "")
+	if !ok {
+		return nil
+	}
+
+	quotaUs, okQ := readCgroupInt64(filepath.Join(root, "cpu", "cpu.cfs_quota_us"), "")
+	periodUs, okP := readCgroupInt64(filepath.Join(root, "cpu", "cpu.cfs_period_us"), "")
+	quota := -1.0
+	if okQ && okP && quotaUs > 0 && periodUs > 0 {
+		quota = float64(quotaUs) / float64(periodUs)
+	}
+
+	return &Cgroup{MemoryLimitBytes: mem, CPUQuota: quota}
+}
+
+// readCgroupInt64 reads an integer value from a cgroup pseudo-file. When
+// unlimitedValue is non-empty and the file contains exactly that string
+// (e.g. "max" for cgroup v2), the limit is reported as unset (-1, true).
+func readCgroupInt64(path, unlimitedValue string) (int64, bool) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return 0, false
+	}
+	value := strings.TrimSpace(string(raw))
+	if unlimitedValue != "" && value == unlimitedValue {
+		return -1, true
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
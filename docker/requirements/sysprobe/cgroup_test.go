@@ -0,0 +1,125 @@
+package sysprobe
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("mkdir %q: %v", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write %q: %v", path, err)
+	}
+}
+
+func TestReadCgroupV2(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "memory.max"), "134217728\n")
+	writeFile(t, filepath.Join(root, "cpu.max"), "200000 100000\n")
+
+	c := readCgroupV2(root)
+	if c == nil {
+		t.Fatal("readCgroupV2 returned nil, want a Cgroup")
+	}
+	if c.MemoryLimitBytes != 134217728 {
+		t.Errorf("MemoryLimitBytes = %d, want 134217728", c.MemoryLimitBytes)
+	}
+	if c.CPUQuota != 2 {
+		t.Errorf("CPUQuota = %v, want 2", c.CPUQuota)
+	}
+}
+
+func TestReadCgroupV2Unlimited(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "memory.max"), "max\n")
+	writeFile(t, filepath.Join(root, "cpu.max"), "max 100000\n")
+
+	c := readCgroupV2(root)
+	if c == nil {
+		t.Fatal("readCgroupV2 returned nil, want a Cgroup reporting unset limits")
+	}
+	if c.MemoryLimitBytes != -1 {
+		t.Errorf("MemoryLimitBytes = %d, want -1 (memory.max is \"max\")", c.MemoryLimitBytes)
+	}
+	if c.CPUQuota != -1 {
+		t.Errorf("CPUQuota = %v, want -1 (cpu.max is \"max\")", c.CPUQuota)
+	}
+}
+
+func TestReadCgroupV2MissingCPUMax(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "memory.max"), "1024\n")
+
+	c := readCgroupV2(root)
+	if c == nil {
+		t.Fatal("readCgroupV2 returned nil, want a Cgroup")
+	}
+	if c.CPUQuota != -1 {
+		t.Errorf("CPUQuota = %v, want -1 (no cpu.max present)", c.CPUQuota)
+	}
+}
+
+func TestReadCgroupV1(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "memory", "memory.limit_in_bytes"), "67108864\n")
+	writeFile(t, filepath.Join(root, "cpu", "cpu.cfs_quota_us"), "50000\n")
+	writeFile(t, filepath.Join(root, "cpu", "cpu.cfs_period_us"), "100000\n")
+
+	c := readCgroupV1(root)
+	if c == nil {
+		t.Fatal("readCgroupV1 returned nil, want a Cgroup")
+	}
+	if c.MemoryLimitBytes != 67108864 {
+		t.Errorf("MemoryLimitBytes = %d, want 67108864", c.MemoryLimitBytes)
+	}
+	if c.CPUQuota != 0.5 {
+		t.Errorf("CPUQuota = %v, want 0.5", c.CPUQuota)
+	}
+}
+
+func TestReadCgroupV1NoQuotaSet(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "memory", "memory.limit_in_bytes"), "67108864\n")
+	writeFile(t, filepath.Join(root, "cpu", "cpu.cfs_quota_us"), "-1\n")
+	writeFile(t, filepath.Join(root, "cpu", "cpu.cfs_period_us"), "100000\n")
+
+	c := readCgroupV1(root)
+	if c == nil {
+		t.Fatal("readCgroupV1 returned nil, want a Cgroup")
+	}
+	if c.CPUQuota != -1 {
+		t.Errorf("CPUQuota = %v, want -1 (negative quota means unset)", c.CPUQuota)
+	}
+}
+
+func TestReadCgroupNotInContainer(t *testing.T) {
+	root := t.TempDir() // empty: no cgroup files at all.
+	if c := readCgroupV2(root); c != nil {
+		t.Errorf("readCgroupV2 = %+v, want nil", c)
+	}
+	if c := readCgroupV1(root); c != nil {
+		t.Errorf("readCgroupV1 = %+v, want nil", c)
+	}
+}
+
+func TestReadCgroupPrefersV2OverV1(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "memory.max"), "1024\n")
+	writeFile(t, filepath.Join(root, "memory", "memory.limit_in_bytes"), "2048\n")
+
+	old := cgroupRoot
+	cgroupRoot = root
+	defer func() { cgroupRoot = old }()
+
+	c := readCgroup()
+	if c == nil {
+		t.Fatal("readCgroup returned nil, want a Cgroup")
+	}
+	if c.MemoryLimitBytes != 1024 {
+		t.Errorf("MemoryLimitBytes = %d, want 1024 (v2 preferred over v1)", c.MemoryLimitBytes)
+	}
+}
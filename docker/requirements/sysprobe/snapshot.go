@@ -0,0 +1,77 @@
+// Package sysprobe samples the resources of the host (or container) a
+// preload job is about to run on, so that job sizing can be based on what
+// is actually available rather than a hardcoded guess.
+package sysprobe
+
+import (
+	"time"
+
+	"github.com/shirou/gopsutil/v4/cpu"
+	"github.com/shirou/gopsutil/v4/disk"
+	"github.com/shirou/gopsutil/v4/mem"
+)
+
+// Snapshot is a point-in-time read of the machine's capacity.
+type Snapshot struct {
+	CPUCount     int       `json:"cpu_count"`
+	CPUPercent   float64   `json:"cpu_percent"`
+	MemTotal     uint64    `json:"mem_total_bytes"`
+	MemAvail     uint64    `json:"mem_available_bytes"`
+	DiskReadOps  uint64    `json:"disk_read_ops"`
+	DiskWriteOps uint64    `json:"disk_write_ops"`
+	Cgroup       *Cgroup   `json:"cgroup,omitempty"`
+	SampledAt    time.Time `json:"sampled_at"`
+}
+
+// Cgroup holds the resource limits imposed on the current process by its
+// container, when one is detected. CPUQuota is -1 when no CPU limit is set,
+// and MemoryLimitBytes is -1 when cgroup v2 reports "max". An unlimited
+// cgroup v1 memory limit is kept as the very large value the kernel reports.
+type Cgroup struct {
+	MemoryLimitBytes int64   `json:"memory_limit_bytes"`
+	CPUQuota         float64 `json:"cpu_quota"`
+}
+
+// Sample reads the current CPU, memory, disk IO, and (if present) cgroup
+// limits of the host.
+func Sample() (Snapshot, error) {
+	count, err := cpu.Counts(true)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	percents, err := cpu.Percent(200*time.Millisecond, false)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	var percent float64
+	if len(percents) > 0 {
+		percent = percents[0]
+	}
+
+	vm, err := mem.VirtualMemory()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	var readOps, writeOps uint64
+	counters, err := disk.IOCounters()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	for _, c := range counters {
+		readOps += c.ReadCount
+		writeOps += c.WriteCount
+	}
+
+	return Snapshot{
+		CPUCount:     count,
+		CPUPercent:   percent,
+		MemTotal:     vm.Total,
+		MemAvail:     vm.Available,
+		DiskReadOps:  readOps,
+		DiskWriteOps: writeOps,
+		Cgroup:       readCgroup(),
+		SampledAt:    time.Now(),
+	}, nil
+}